@@ -0,0 +1,101 @@
+// Package game implements the core number-guessing loop, independent of
+// where its input comes from or where its output goes.
+package game
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math/rand"
+	"strconv"
+	"strings"
+)
+
+// Config holds the parameters for a single game.
+type Config struct {
+	Min         int
+	Max         int
+	MaxAttempts int
+	Source      rand.Source
+	Hints       bool
+}
+
+// Result describes the outcome of a finished game.
+type Result struct {
+	Target   int
+	Attempts int
+	Won      bool
+}
+
+// CheckGuess compares guess against target and returns a human-readable
+// feedback message along with whether the guess was correct.
+func CheckGuess(guess, target int) (feedback string, won bool) {
+	switch {
+	case guess == target:
+		return "Correct!", true
+	case guess < target:
+		return "Too low! Try a higher number.", false
+	default:
+		return "Too high! Try a lower number.", false
+	}
+}
+
+// Run plays one game of number-guessing, reading guesses from r and writing
+// prompts and feedback to w. It returns once the player wins or exhausts
+// cfg.MaxAttempts, or if r runs out of input.
+func Run(w io.Writer, r io.Reader, cfg Config) Result {
+	rng := rand.New(cfg.Source)
+	target := rng.Intn(cfg.Max-cfg.Min+1) + cfg.Min
+	attempts := 0
+
+	fmt.Fprintln(w, "Welcome to the Number Guessing Game!")
+	fmt.Fprintf(w, "I'm thinking of a number between %d and %d.\n", cfg.Min, cfg.Max)
+	fmt.Fprintf(w, "You have %d attempts to guess it.\n\n", cfg.MaxAttempts)
+
+	scanner := bufio.NewScanner(r)
+	previousDistance := -1
+
+	for attempts < cfg.MaxAttempts {
+		fmt.Fprintf(w, "Attempt %d/%d - Enter your guess: ", attempts+1, cfg.MaxAttempts)
+
+		if !scanner.Scan() {
+			break
+		}
+
+		input := strings.TrimSpace(scanner.Text())
+		guess, err := strconv.Atoi(input)
+
+		if err != nil {
+			fmt.Fprintf(w, "Invalid input! Please enter a number between %d and %d.\n", cfg.Min, cfg.Max)
+			continue
+		}
+
+		if guess < cfg.Min || guess > cfg.Max {
+			fmt.Fprintf(w, "Please enter a number between %d and %d.\n", cfg.Min, cfg.Max)
+			continue
+		}
+
+		attempts++
+
+		feedback, won := CheckGuess(guess, target)
+		if won {
+			fmt.Fprintf(w, "\nCongratulations! You guessed the number in %d attempts!\n", attempts)
+			return Result{Target: target, Attempts: attempts, Won: true}
+		}
+
+		fmt.Fprint(w, feedback)
+		if cfg.Hints {
+			distance := abs(guess - target)
+			if hint := hintMessage(distance, previousDistance, cfg.Max-cfg.Min+1); hint != "" {
+				fmt.Fprintf(w, " %s", hint)
+			}
+			previousDistance = distance
+		}
+		fmt.Fprintln(w)
+		fmt.Fprintln(w)
+	}
+
+	fmt.Fprintf(w, "\nGame Over! You've used all %d attempts.\n", cfg.MaxAttempts)
+	fmt.Fprintf(w, "The number was: %d\n", target)
+	return Result{Target: target, Attempts: attempts, Won: false}
+}
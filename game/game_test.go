@@ -0,0 +1,132 @@
+package game
+
+import (
+	"bytes"
+	"fmt"
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+func TestCheckGuess(t *testing.T) {
+	tests := []struct {
+		name         string
+		guess        int
+		target       int
+		wantFeedback string
+		wantWon      bool
+	}{
+		{"correct", 50, 50, "Correct!", true},
+		{"too low", 10, 50, "Too low! Try a higher number.", false},
+		{"too high", 90, 50, "Too high! Try a lower number.", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			feedback, won := CheckGuess(tt.guess, tt.target)
+			if feedback != tt.wantFeedback || won != tt.wantWon {
+				t.Errorf("CheckGuess(%d, %d) = (%q, %v), want (%q, %v)",
+					tt.guess, tt.target, feedback, won, tt.wantFeedback, tt.wantWon)
+			}
+		})
+	}
+}
+
+// targetFor reproduces the target Run will pick for a given seed and range,
+// so tests can script the right input without depending on Run's internals.
+func targetFor(seed int64, min, max int) int {
+	rng := rand.New(rand.NewSource(seed))
+	return rng.Intn(max-min+1) + min
+}
+
+func TestRunWins(t *testing.T) {
+	const seed = 1
+	cfg := Config{Min: 1, Max: 100, MaxAttempts: 7, Source: rand.NewSource(seed)}
+	target := targetFor(seed, cfg.Min, cfg.Max)
+
+	in := strings.NewReader(fmt.Sprintf("%d\n", target))
+	var out bytes.Buffer
+
+	result := Run(&out, in, cfg)
+
+	if !result.Won {
+		t.Fatalf("expected win, got loss; output:\n%s", out.String())
+	}
+	if result.Attempts != 1 {
+		t.Errorf("Attempts = %d, want 1", result.Attempts)
+	}
+	if result.Target != target {
+		t.Errorf("Target = %d, want %d", result.Target, target)
+	}
+	if !strings.Contains(out.String(), "Congratulations!") {
+		t.Errorf("output missing congratulations message:\n%s", out.String())
+	}
+}
+
+func TestRunLoses(t *testing.T) {
+	const seed = 1
+	cfg := Config{Min: 1, Max: 100, MaxAttempts: 3, Source: rand.NewSource(seed)}
+	target := targetFor(seed, cfg.Min, cfg.Max)
+
+	wrong := target + 1
+	if wrong > cfg.Max {
+		wrong = target - 1
+	}
+
+	in := strings.NewReader(strings.Repeat(fmt.Sprintf("%d\n", wrong), cfg.MaxAttempts))
+	var out bytes.Buffer
+
+	result := Run(&out, in, cfg)
+
+	if result.Won {
+		t.Fatalf("expected loss, got win; output:\n%s", out.String())
+	}
+	if result.Attempts != cfg.MaxAttempts {
+		t.Errorf("Attempts = %d, want %d", result.Attempts, cfg.MaxAttempts)
+	}
+	if !strings.Contains(out.String(), "Game Over!") {
+		t.Errorf("output missing game over message:\n%s", out.String())
+	}
+}
+
+func TestRunInvalidInput(t *testing.T) {
+	const seed = 1
+	cfg := Config{Min: 1, Max: 100, MaxAttempts: 7, Source: rand.NewSource(seed)}
+	target := targetFor(seed, cfg.Min, cfg.Max)
+
+	in := strings.NewReader(fmt.Sprintf("not-a-number\n%d\n", target))
+	var out bytes.Buffer
+
+	result := Run(&out, in, cfg)
+
+	if !result.Won {
+		t.Fatalf("expected win after invalid input, got loss; output:\n%s", out.String())
+	}
+	if result.Attempts != 1 {
+		t.Errorf("Attempts = %d, want 1 (invalid input should not consume an attempt)", result.Attempts)
+	}
+	if !strings.Contains(out.String(), "Invalid input!") {
+		t.Errorf("output missing invalid input message:\n%s", out.String())
+	}
+}
+
+func TestRunOutOfRangeGuess(t *testing.T) {
+	const seed = 1
+	cfg := Config{Min: 1, Max: 100, MaxAttempts: 7, Source: rand.NewSource(seed)}
+	target := targetFor(seed, cfg.Min, cfg.Max)
+
+	in := strings.NewReader(fmt.Sprintf("200\n%d\n", target))
+	var out bytes.Buffer
+
+	result := Run(&out, in, cfg)
+
+	if !result.Won {
+		t.Fatalf("expected win after out-of-range guess, got loss; output:\n%s", out.String())
+	}
+	if result.Attempts != 1 {
+		t.Errorf("Attempts = %d, want 1 (out-of-range guess should not consume an attempt)", result.Attempts)
+	}
+	if !strings.Contains(out.String(), "Please enter a number between") {
+		t.Errorf("output missing range message:\n%s", out.String())
+	}
+}
@@ -0,0 +1,52 @@
+package game
+
+import "fmt"
+
+// hintMessage builds the proximity suffix appended to a guess's feedback
+// when Config.Hints is enabled. On the first guess (previousDistance < 0)
+// there is nothing to compare against yet, so it returns no suffix at all -
+// only directional feedback is shown. On later guesses it reports both the
+// absolute proximity bucket and whether the guess moved the player closer
+// ("warmer") or farther ("colder") than the last one.
+func hintMessage(distance, previousDistance, rangeSize int) string {
+	if previousDistance < 0 {
+		return ""
+	}
+
+	bucket := proximityBucket(distance, rangeSize)
+
+	switch {
+	case distance < previousDistance:
+		return fmt.Sprintf("(warmer - %s)", bucket)
+	case distance > previousDistance:
+		return fmt.Sprintf("(colder - %s)", bucket)
+	default:
+		return fmt.Sprintf("(same distance - %s)", bucket)
+	}
+}
+
+// proximityBucket classifies distance as a fraction of rangeSize into one of
+// five buckets, from closest to farthest: burning, hot, warm, cold, freezing.
+func proximityBucket(distance, rangeSize int) string {
+	pct := float64(distance) / float64(rangeSize) * 100
+
+	switch {
+	case pct <= 2:
+		return "burning"
+	case pct <= 5:
+		return "hot"
+	case pct <= 10:
+		return "warm"
+	case pct <= 25:
+		return "cold"
+	default:
+		return "freezing"
+	}
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
@@ -0,0 +1,63 @@
+package game
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestProximityBucketThresholds(t *testing.T) {
+	const rangeSize = 100 // 1% of range == 1 unit of distance
+
+	tests := []struct {
+		distance int
+		want     string
+	}{
+		{0, "burning"},
+		{2, "burning"},
+		{3, "hot"},
+		{5, "hot"},
+		{6, "warm"},
+		{10, "warm"},
+		{11, "cold"},
+		{25, "cold"},
+		{26, "freezing"},
+		{100, "freezing"},
+	}
+
+	for _, tt := range tests {
+		got := proximityBucket(tt.distance, rangeSize)
+		if got != tt.want {
+			t.Errorf("proximityBucket(%d, %d) = %q, want %q", tt.distance, rangeSize, got, tt.want)
+		}
+	}
+}
+
+func TestHintMessageFirstGuessHasNoVerdict(t *testing.T) {
+	got := hintMessage(10, -1, 100)
+	if got != "" {
+		t.Errorf("hintMessage() = %q, want empty string (first guess has no proximity feedback)", got)
+	}
+}
+
+func TestHintMessageWarmerAndColder(t *testing.T) {
+	tests := []struct {
+		name             string
+		distance, prev   int
+		rangeSize        int
+		wantVerdictWords string
+	}{
+		{"warmer", 5, 20, 100, "warmer"},
+		{"colder", 20, 5, 100, "colder"},
+		{"same", 10, 10, 100, "same distance"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := hintMessage(tt.distance, tt.prev, tt.rangeSize)
+			if !strings.Contains(got, tt.wantVerdictWords) {
+				t.Errorf("hintMessage(%d, %d, %d) = %q, want it to contain %q",
+					tt.distance, tt.prev, tt.rangeSize, got, tt.wantVerdictWords)
+			}
+		})
+	}
+}
@@ -0,0 +1,72 @@
+package game
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"strings"
+)
+
+// ReverseResult describes the outcome of a reverse-mode game, where the
+// program is the one guessing.
+type ReverseResult struct {
+	Guess         int
+	Attempts      int
+	Optimal       int
+	CheatDetected bool
+	Aborted       bool
+}
+
+// RunReverse flips the usual roles: the player picks a secret number in
+// [cfg.Min, cfg.Max] and the program guesses it via binary search, reading
+// "h" (higher), "l" (lower), or "c" (correct) from r after each guess. If
+// the player's answers ever make the remaining range empty, RunReverse
+// reports the contradiction and returns with CheatDetected set. If r runs
+// out of input before that happens, it returns with Aborted set instead.
+func RunReverse(w io.Writer, r io.Reader, cfg Config) ReverseResult {
+	lo, hi := cfg.Min, cfg.Max
+	attempts := 0
+
+	fmt.Fprintf(w, "Reverse mode! Think of a number between %d and %d.\n", cfg.Min, cfg.Max)
+	fmt.Fprintln(w, "I'll guess; answer (h)igher, (l)ower, or (c)orrect.")
+	fmt.Fprintln(w)
+
+	scanner := bufio.NewScanner(r)
+
+	for lo <= hi {
+		guess := (lo + hi) / 2
+		attempts++
+		fmt.Fprintf(w, "Attempt %d - My guess is %d. Higher, lower, or correct (h/l/c)? ", attempts, guess)
+
+		if !scanner.Scan() {
+			fmt.Fprintln(w, "\nNo more input - game aborted.")
+			return ReverseResult{Attempts: attempts, Aborted: true}
+		}
+
+		switch strings.ToLower(strings.TrimSpace(scanner.Text())) {
+		case "c":
+			optimal := optimalAttempts(cfg.Min, cfg.Max)
+			fmt.Fprintf(w, "\nGot it! I guessed %d in %d attempts.\n", guess, attempts)
+			fmt.Fprintf(w, "Optimal play needs %d attempts for range [%d, %d].\n", optimal, cfg.Min, cfg.Max)
+			return ReverseResult{Guess: guess, Attempts: attempts, Optimal: optimal}
+		case "h":
+			lo = guess + 1
+		case "l":
+			hi = guess - 1
+		default:
+			fmt.Fprintln(w, "Please answer h, l, or c.")
+			attempts--
+		}
+	}
+
+	fmt.Fprintf(w, "\nThat's not possible: your answers leave no number between %d and %d, "+
+		"which means one of them contradicted an earlier one.\n", lo, hi)
+	return ReverseResult{Attempts: attempts, CheatDetected: true}
+}
+
+// optimalAttempts returns the fewest guesses a perfect binary search needs
+// to find any number in [min, max]: ceil(log2(range size + 1)).
+func optimalAttempts(min, max int) int {
+	return int(math.Ceil(math.Log2(float64(max-min+1) + 1)))
+}
@@ -0,0 +1,94 @@
+package game
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestRunReverseFindsNumber(t *testing.T) {
+	cfg := Config{Min: 1, Max: 100}
+	// Steer the binary search down to 1 with five "lower" answers, then confirm.
+	in := strings.NewReader("l\nl\nl\nl\nl\nc\n")
+	var out bytes.Buffer
+
+	result := RunReverse(&out, in, cfg)
+
+	if result.CheatDetected {
+		t.Fatalf("expected no cheat detected; output:\n%s", out.String())
+	}
+	if result.Attempts == 0 {
+		t.Errorf("Attempts = 0, want > 0")
+	}
+	if !strings.Contains(out.String(), "Got it!") {
+		t.Errorf("output missing success message:\n%s", out.String())
+	}
+}
+
+func TestRunReverseDetectsCheating(t *testing.T) {
+	cfg := Config{Min: 1, Max: 10}
+	// First guess is (1+10)/2 = 5. Alternate higher and lower answers
+	// (5 h, 8 l, 6 h, 7 l) until the interval collapses to empty.
+	in := strings.NewReader(strings.Repeat("h\nl\n", 10))
+	var out bytes.Buffer
+
+	result := RunReverse(&out, in, cfg)
+
+	if !result.CheatDetected {
+		t.Fatalf("expected cheat detected; output:\n%s", out.String())
+	}
+	if !strings.Contains(out.String(), "not possible") {
+		t.Errorf("output missing contradiction message:\n%s", out.String())
+	}
+}
+
+func TestRunReverseAbortsOnEOF(t *testing.T) {
+	cfg := Config{Min: 1, Max: 10}
+	in := strings.NewReader("") // no input at all, simulating Ctrl-D / a dry pipe
+	var out bytes.Buffer
+
+	result := RunReverse(&out, in, cfg)
+
+	if result.CheatDetected {
+		t.Fatalf("expected no cheat detected on EOF; output:\n%s", out.String())
+	}
+	if !result.Aborted {
+		t.Errorf("Aborted = false, want true")
+	}
+	if strings.Contains(out.String(), "not possible") {
+		t.Errorf("output wrongly accuses the player of contradicting themselves:\n%s", out.String())
+	}
+}
+
+func TestRunReverseIgnoresInvalidAnswers(t *testing.T) {
+	cfg := Config{Min: 1, Max: 10}
+	in := strings.NewReader("what\nc\n")
+	var out bytes.Buffer
+
+	result := RunReverse(&out, in, cfg)
+
+	if result.CheatDetected {
+		t.Fatalf("expected no cheat detected; output:\n%s", out.String())
+	}
+	if result.Attempts != 1 {
+		t.Errorf("Attempts = %d, want 1 (invalid answer shouldn't count)", result.Attempts)
+	}
+}
+
+func TestOptimalAttempts(t *testing.T) {
+	tests := []struct {
+		min, max int
+		want     int
+	}{
+		{1, 1, 1},
+		{1, 100, 7},
+		{1, 7, 3},
+	}
+
+	for _, tt := range tests {
+		got := optimalAttempts(tt.min, tt.max)
+		if got != tt.want {
+			t.Errorf("optimalAttempts(%d, %d) = %d, want %d", tt.min, tt.max, got, tt.want)
+		}
+	}
+}
@@ -2,59 +2,217 @@ package main
 
 import (
 	"bufio"
+	"flag"
 	"fmt"
+	"io"
 	"math/rand"
+	"net"
 	"os"
-	"strconv"
 	"strings"
 	"time"
+
+	"github.com/misterclayt0n/the-editor/game"
+	"github.com/misterclayt0n/the-editor/netgame"
+	"github.com/misterclayt0n/the-editor/scores"
 )
 
-func main() {
-	rand.Seed(time.Now().UnixNano())
-	target := rand.Intn(100) + 1
-	attempts := 0
-	maxAttempts := 7
+// difficultyPreset bundles the range and attempt count a -difficulty name
+// resolves to before any explicit -min/-max/-attempts flags are applied.
+type difficultyPreset struct {
+	min, max, attempts int
+}
 
-	fmt.Println("Welcome to the Number Guessing Game!")
-	fmt.Printf("I'm thinking of a number between 1 and 100.\n")
-	fmt.Printf("You have %d attempts to guess it.\n\n", maxAttempts)
+var difficultyPresets = map[string]difficultyPreset{
+	"easy":   {min: 1, max: 50, attempts: 10},
+	"medium": {min: 1, max: 100, attempts: 7},
+	"hard":   {min: 1, max: 500, attempts: 5},
+}
 
-	scanner := bufio.NewScanner(os.Stdin)
+func main() {
+	difficultyFlag := flag.String("difficulty", "medium", "difficulty preset: easy, medium, or hard")
+	minFlag := flag.Int("min", 0, "minimum number to guess (overrides difficulty)")
+	maxFlag := flag.Int("max", 0, "maximum number to guess (overrides difficulty)")
+	attemptsFlag := flag.Int("attempts", 0, "number of attempts allowed (overrides difficulty)")
+	seedFlag := flag.Int64("seed", 0, "RNG seed for a reproducible game (0 = time-seeded)")
+	leaderboardFlag := flag.Bool("leaderboard", false, "print the top 10 scores and exit")
+	resetScoresFlag := flag.Bool("reset-scores", false, "clear the scores file and exit")
+	reverseFlag := flag.Bool("reverse", false, "reverse mode: you pick the number, the program guesses it")
+	serveFlag := flag.String("serve", "", "host a two-player game on addr, e.g. :4000")
+	connectFlag := flag.String("connect", "", "join a two-player game hosted at addr")
+	hintsFlag := flag.Bool("hints", false, "add warmer/colder proximity feedback to guesses")
+	flag.Parse()
 
-	for attempts < maxAttempts {
-		fmt.Printf("Attempt %d/%d - Enter your guess: ", attempts+1, maxAttempts)
+	scoresPath, err := scores.DefaultPath()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "Error:", err)
+		os.Exit(1)
+	}
+	store := scores.NewFileStore(scoresPath)
 
-		if !scanner.Scan() {
-			break
+	if *resetScoresFlag {
+		if err := store.Reset(); err != nil {
+			fmt.Fprintln(os.Stderr, "Error:", err)
+			os.Exit(1)
 		}
+		fmt.Println("Scores cleared.")
+		return
+	}
+
+	if *leaderboardFlag {
+		if err := printLeaderboard(os.Stdout, store); err != nil {
+			fmt.Fprintln(os.Stderr, "Error:", err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	explicit := make(map[string]bool)
+	flag.Visit(func(f *flag.Flag) { explicit[f.Name] = true })
+
+	cfg, err := resolveConfig(*difficultyFlag, *minFlag, *maxFlag, *attemptsFlag, explicit)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "Error:", err)
+		os.Exit(1)
+	}
 
-		input := strings.TrimSpace(scanner.Text())
-		guess, err := strconv.Atoi(input)
+	seed := *seedFlag
+	if seed == 0 {
+		seed = time.Now().UnixNano()
+	}
+	cfg.Source = rand.NewSource(seed)
+	cfg.Hints = *hintsFlag
 
-		if err != nil {
-			fmt.Println("Invalid input! Please enter a number between 1 and 100.")
-			continue
+	if *serveFlag != "" {
+		if err := runServe(*serveFlag, cfg); err != nil {
+			fmt.Fprintln(os.Stderr, "Error:", err)
+			os.Exit(1)
 		}
+		return
+	}
 
-		if guess < 1 || guess > 100 {
-			fmt.Println("Please enter a number between 1 and 100.")
-			continue
+	if *connectFlag != "" {
+		if err := runConnect(*connectFlag, os.Stdin, os.Stdout); err != nil {
+			fmt.Fprintln(os.Stderr, "Error:", err)
+			os.Exit(1)
 		}
+		return
+	}
 
-		attempts++
+	if *reverseFlag {
+		game.RunReverse(os.Stdout, os.Stdin, cfg)
+		return
+	}
 
-		if guess == target {
-			fmt.Printf("\nCongratulations! You guessed the number in %d attempts!\n", attempts)
-			return
-		} else if guess < target {
-			fmt.Println("Too low! Try a higher number.")
-		} else {
-			fmt.Println("Too high! Try a lower number.")
-		}
-		fmt.Println()
+	fmt.Printf("Configuration: range [%d, %d], %d attempts, difficulty=%s\n\n",
+		cfg.Min, cfg.Max, cfg.MaxAttempts, *difficultyFlag)
+
+	start := time.Now()
+	result := game.Run(os.Stdout, os.Stdin, cfg)
+	duration := time.Since(start)
+
+	player := promptPlayerName(os.Stdout, os.Stdin)
+	err = store.Save(scores.Score{
+		Player:     player,
+		Difficulty: *difficultyFlag,
+		Attempts:   result.Attempts,
+		Duration:   duration,
+		Timestamp:  time.Now(),
+		Won:        result.Won,
+	})
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "Error saving score:", err)
+	}
+}
+
+// runServe hosts a two-player game on addr and plays one match against
+// whichever client connects first.
+func runServe(addr string, cfg game.Config) error {
+	l, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+	defer l.Close()
+
+	fmt.Printf("Waiting for an opponent on %s...\n", addr)
+	return netgame.Serve(l, cfg)
+}
+
+// runConnect joins a two-player game hosted at addr and plays it out,
+// reading guesses from in and writing prompts and feedback to out.
+func runConnect(addr string, in io.Reader, out io.Writer) error {
+	conn, err := net.Dial("tcp", addr)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	return netgame.Dial(conn, in, out)
+}
+
+// promptPlayerName asks for and returns the player's name, defaulting to
+// "anonymous" if no name is entered or input runs out.
+func promptPlayerName(w io.Writer, r io.Reader) string {
+	fmt.Fprint(w, "Enter your name for the leaderboard: ")
+
+	scanner := bufio.NewScanner(r)
+	if !scanner.Scan() {
+		return "anonymous"
+	}
+
+	name := strings.TrimSpace(scanner.Text())
+	if name == "" {
+		return "anonymous"
+	}
+	return name
+}
+
+// printLeaderboard prints the top 10 scores from store, fewest attempts first.
+func printLeaderboard(w io.Writer, store scores.Store) error {
+	all, err := store.Load()
+	if err != nil {
+		return err
+	}
+
+	top := scores.Top(all, 10)
+	if len(top) == 0 {
+		fmt.Fprintln(w, "No scores recorded yet.")
+		return nil
+	}
+
+	fmt.Fprintln(w, "Leaderboard (top 10 by fewest attempts):")
+	for i, s := range top {
+		fmt.Fprintf(w, "%2d. %-15s %d attempts, %s, %s (%s)\n",
+			i+1, s.Player, s.Attempts, s.Duration.Round(time.Second), s.Difficulty, s.Timestamp.Format("2006-01-02 15:04"))
+	}
+	return nil
+}
+
+// resolveConfig builds a game.Config from a difficulty preset, overridden by
+// whichever of min, max, and attempts were explicitly passed on the command
+// line, and validates the result.
+func resolveConfig(difficultyName string, min, max, attempts int, explicit map[string]bool) (game.Config, error) {
+	preset, ok := difficultyPresets[difficultyName]
+	if !ok {
+		return game.Config{}, fmt.Errorf("unknown difficulty %q: must be easy, medium, or hard", difficultyName)
+	}
+
+	cfg := game.Config{Min: preset.min, Max: preset.max, MaxAttempts: preset.attempts}
+	if explicit["min"] {
+		cfg.Min = min
+	}
+	if explicit["max"] {
+		cfg.Max = max
+	}
+	if explicit["attempts"] {
+		cfg.MaxAttempts = attempts
+	}
+
+	if cfg.Min >= cfg.Max {
+		return game.Config{}, fmt.Errorf("min (%d) must be less than max (%d)", cfg.Min, cfg.Max)
+	}
+	if cfg.MaxAttempts <= 0 {
+		return game.Config{}, fmt.Errorf("attempts (%d) must be greater than 0", cfg.MaxAttempts)
 	}
 
-	fmt.Printf("\nGame Over! You've used all %d attempts.\n", maxAttempts)
-	fmt.Printf("The number was: %d\n", target)
+	return cfg, nil
 }
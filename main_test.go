@@ -0,0 +1,143 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/misterclayt0n/the-editor/scores"
+)
+
+func TestResolveConfig(t *testing.T) {
+	tests := []struct {
+		name       string
+		difficulty string
+		min, max   int
+		attempts   int
+		explicit   map[string]bool
+		wantErr    bool
+		wantMin    int
+		wantMax    int
+		wantAtt    int
+	}{
+		{
+			name:       "medium defaults",
+			difficulty: "medium",
+			explicit:   map[string]bool{},
+			wantMin:    1, wantMax: 100, wantAtt: 7,
+		},
+		{
+			name:       "easy defaults",
+			difficulty: "easy",
+			explicit:   map[string]bool{},
+			wantMin:    1, wantMax: 50, wantAtt: 10,
+		},
+		{
+			name:       "explicit overrides",
+			difficulty: "medium",
+			min:        1, max: 10, attempts: 3,
+			explicit: map[string]bool{"min": true, "max": true, "attempts": true},
+			wantMin:  1, wantMax: 10, wantAtt: 3,
+		},
+		{
+			name:       "unknown difficulty",
+			difficulty: "impossible",
+			explicit:   map[string]bool{},
+			wantErr:    true,
+		},
+		{
+			name:       "min must be less than max",
+			difficulty: "medium",
+			min:        10, max: 10,
+			explicit: map[string]bool{"min": true, "max": true},
+			wantErr:  true,
+		},
+		{
+			name:       "attempts must be positive",
+			difficulty: "medium",
+			attempts:   0,
+			explicit:   map[string]bool{"attempts": true},
+			wantErr:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := resolveConfig(tt.difficulty, tt.min, tt.max, tt.attempts, tt.explicit)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("resolveConfig() = %+v, nil, want error", cfg)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("resolveConfig() unexpected error: %v", err)
+			}
+			if cfg.Min != tt.wantMin || cfg.Max != tt.wantMax || cfg.MaxAttempts != tt.wantAtt {
+				t.Errorf("resolveConfig() = {Min:%d Max:%d MaxAttempts:%d}, want {Min:%d Max:%d MaxAttempts:%d}",
+					cfg.Min, cfg.Max, cfg.MaxAttempts, tt.wantMin, tt.wantMax, tt.wantAtt)
+			}
+		})
+	}
+}
+
+// memStore is an in-memory scores.Store for tests.
+type memStore struct {
+	scores []scores.Score
+}
+
+func (m *memStore) Load() ([]scores.Score, error) { return m.scores, nil }
+
+func (m *memStore) Save(s scores.Score) error {
+	m.scores = append(m.scores, s)
+	return nil
+}
+
+func TestPrintLeaderboardEmpty(t *testing.T) {
+	var out bytes.Buffer
+	if err := printLeaderboard(&out, &memStore{}); err != nil {
+		t.Fatalf("printLeaderboard() error: %v", err)
+	}
+	if !strings.Contains(out.String(), "No scores recorded yet.") {
+		t.Errorf("output = %q, want empty-leaderboard message", out.String())
+	}
+}
+
+func TestPrintLeaderboardRanksByAttempts(t *testing.T) {
+	store := &memStore{scores: []scores.Score{
+		{Player: "slow", Attempts: 5, Won: true},
+		{Player: "fast", Attempts: 2, Won: true},
+		{Player: "lost", Attempts: 1, Won: false},
+	}}
+
+	var out bytes.Buffer
+	if err := printLeaderboard(&out, store); err != nil {
+		t.Fatalf("printLeaderboard() error: %v", err)
+	}
+
+	output := out.String()
+	fastIdx := strings.Index(output, "fast")
+	slowIdx := strings.Index(output, "slow")
+	if fastIdx == -1 || slowIdx == -1 || fastIdx > slowIdx {
+		t.Errorf("expected fast to be listed before slow, got:\n%s", output)
+	}
+	if strings.Contains(output, "lost") {
+		t.Errorf("expected losing game to be excluded, got:\n%s", output)
+	}
+}
+
+func TestPromptPlayerNameDefaultsToAnonymous(t *testing.T) {
+	var out bytes.Buffer
+	name := promptPlayerName(&out, strings.NewReader("\n"))
+	if name != "anonymous" {
+		t.Errorf("promptPlayerName() = %q, want %q", name, "anonymous")
+	}
+}
+
+func TestPromptPlayerNameTrimsInput(t *testing.T) {
+	var out bytes.Buffer
+	name := promptPlayerName(&out, strings.NewReader("  Ada  \n"))
+	if name != "Ada" {
+		t.Errorf("promptPlayerName() = %q, want %q", name, "Ada")
+	}
+}
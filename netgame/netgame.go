@@ -0,0 +1,122 @@
+// Package netgame implements a two-player number-guessing match over a
+// simple line-based protocol on top of net.Conn:
+//
+//	client -> server: GUESS <n>\n
+//	server -> client: LOW\n | HIGH\n | WIN <attempts>\n | LOSE <target>\n
+//	server -> client on a malformed line: ERR <reason>\n
+package netgame
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math/rand"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/misterclayt0n/the-editor/game"
+)
+
+// Serve accepts a single connection from l, picks a random target in
+// cfg.Min..cfg.Max, and plays one game against the connecting client.
+func Serve(l net.Listener, cfg game.Config) error {
+	conn, err := l.Accept()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	return serveConn(conn, cfg)
+}
+
+func serveConn(conn net.Conn, cfg game.Config) error {
+	rng := rand.New(cfg.Source)
+	target := rng.Intn(cfg.Max-cfg.Min+1) + cfg.Min
+
+	reader := bufio.NewReader(conn)
+	attempts := 0
+
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			return err
+		}
+
+		parts := strings.Fields(line)
+		if len(parts) != 2 || parts[0] != "GUESS" {
+			fmt.Fprintf(conn, "ERR invalid command\n")
+			continue
+		}
+
+		guess, err := strconv.Atoi(parts[1])
+		if err != nil {
+			fmt.Fprintf(conn, "ERR invalid number\n")
+			continue
+		}
+		attempts++
+
+		_, won := game.CheckGuess(guess, target)
+		switch {
+		case won:
+			fmt.Fprintf(conn, "WIN %d\n", attempts)
+			return nil
+		case attempts >= cfg.MaxAttempts:
+			fmt.Fprintf(conn, "LOSE %d\n", target)
+			return nil
+		case guess < target:
+			fmt.Fprintf(conn, "LOW\n")
+		default:
+			fmt.Fprintf(conn, "HIGH\n")
+		}
+	}
+}
+
+// Dial plays one game as the guessing client over conn, reading guesses
+// from in and writing prompts and feedback to out.
+func Dial(conn net.Conn, in io.Reader, out io.Writer) error {
+	scanner := bufio.NewScanner(in)
+	connReader := bufio.NewReader(conn)
+
+	for {
+		fmt.Fprint(out, "Enter your guess: ")
+		if !scanner.Scan() {
+			return nil
+		}
+
+		guess, err := strconv.Atoi(strings.TrimSpace(scanner.Text()))
+		if err != nil {
+			fmt.Fprintln(out, "Invalid input! Please enter a number.")
+			continue
+		}
+
+		if _, err := fmt.Fprintf(conn, "GUESS %d\n", guess); err != nil {
+			return err
+		}
+
+		resp, err := connReader.ReadString('\n')
+		if err != nil {
+			return err
+		}
+
+		fields := strings.Fields(resp)
+		if len(fields) == 0 {
+			continue
+		}
+
+		switch {
+		case fields[0] == "LOW":
+			fmt.Fprintln(out, "Too low! Try a higher number.")
+		case fields[0] == "HIGH":
+			fmt.Fprintln(out, "Too high! Try a lower number.")
+		case fields[0] == "WIN" && len(fields) >= 2:
+			fmt.Fprintf(out, "\nYou win! Guessed it in %s attempts.\n", fields[1])
+			return nil
+		case fields[0] == "LOSE" && len(fields) >= 2:
+			fmt.Fprintf(out, "\nYou lose! The number was %s.\n", fields[1])
+			return nil
+		default:
+			fmt.Fprintf(out, "Unexpected server response: %s", resp)
+		}
+	}
+}
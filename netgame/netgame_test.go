@@ -0,0 +1,141 @@
+package netgame
+
+import (
+	"bufio"
+	"bytes"
+	"math/rand"
+	"net"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/misterclayt0n/the-editor/game"
+)
+
+func TestServeConnWin(t *testing.T) {
+	serverConn, clientConn := net.Pipe()
+	defer serverConn.Close()
+	defer clientConn.Close()
+
+	cfg := game.Config{Min: 1, Max: 100, MaxAttempts: 7, Source: rand.NewSource(1)}
+	target := rand.New(rand.NewSource(1)).Intn(cfg.Max-cfg.Min+1) + cfg.Min
+
+	done := make(chan error, 1)
+	go func() { done <- serveConn(serverConn, cfg) }()
+
+	var out bytes.Buffer
+	if err := Dial(clientConn, strings.NewReader(strconv.Itoa(target)+"\n"), &out); err != nil {
+		t.Fatalf("Dial() error: %v", err)
+	}
+
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatalf("serveConn() error: %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("serveConn() did not finish")
+	}
+
+	if !strings.Contains(out.String(), "You win!") {
+		t.Errorf("output missing win message:\n%s", out.String())
+	}
+}
+
+func TestServeConnLoss(t *testing.T) {
+	serverConn, clientConn := net.Pipe()
+	defer serverConn.Close()
+	defer clientConn.Close()
+
+	cfg := game.Config{Min: 1, Max: 100, MaxAttempts: 2, Source: rand.NewSource(1)}
+	target := rand.New(rand.NewSource(1)).Intn(cfg.Max-cfg.Min+1) + cfg.Min
+
+	wrong := target + 1
+	if wrong > cfg.Max {
+		wrong = target - 1
+	}
+
+	done := make(chan error, 1)
+	go func() { done <- serveConn(serverConn, cfg) }()
+
+	in := strings.NewReader(strconv.Itoa(wrong) + "\n" + strconv.Itoa(wrong) + "\n")
+	var out bytes.Buffer
+	if err := Dial(clientConn, in, &out); err != nil {
+		t.Fatalf("Dial() error: %v", err)
+	}
+
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatalf("serveConn() error: %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("serveConn() did not finish")
+	}
+
+	if !strings.Contains(out.String(), "You lose!") {
+		t.Errorf("output missing lose message:\n%s", out.String())
+	}
+}
+
+func TestServeListener(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("net.Listen() error: %v", err)
+	}
+	defer l.Close()
+
+	cfg := game.Config{Min: 1, Max: 10, MaxAttempts: 5, Source: rand.NewSource(1)}
+	target := rand.New(rand.NewSource(1)).Intn(cfg.Max-cfg.Min+1) + cfg.Min
+
+	done := make(chan error, 1)
+	go func() { done <- Serve(l, cfg) }()
+
+	conn, err := net.Dial("tcp", l.Addr().String())
+	if err != nil {
+		t.Fatalf("net.Dial() error: %v", err)
+	}
+	defer conn.Close()
+
+	var out bytes.Buffer
+	if err := Dial(conn, strings.NewReader(strconv.Itoa(target)+"\n"), &out); err != nil {
+		t.Fatalf("Dial() error: %v", err)
+	}
+
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatalf("Serve() error: %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Serve() did not finish")
+	}
+
+	if !strings.Contains(out.String(), "You win!") {
+		t.Errorf("output missing win message:\n%s", out.String())
+	}
+}
+
+func TestDialHandlesTruncatedResponse(t *testing.T) {
+	serverConn, clientConn := net.Pipe()
+	defer serverConn.Close()
+	defer clientConn.Close()
+
+	go func() {
+		// Read the client's guess, then reply with a malformed/truncated
+		// WIN response missing its attempts field - this must not crash Dial.
+		bufio.NewReader(serverConn).ReadString('\n')
+		serverConn.Write([]byte("WIN\n"))
+	}()
+
+	var out bytes.Buffer
+	err := Dial(clientConn, strings.NewReader("5\n"), &out)
+	if err != nil {
+		t.Fatalf("Dial() error: %v", err)
+	}
+
+	if !strings.Contains(out.String(), "Unexpected server response") {
+		t.Errorf("output missing unexpected-response message:\n%s", out.String())
+	}
+}
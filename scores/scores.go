@@ -0,0 +1,120 @@
+// Package scores records completed games to persistent storage and ranks
+// them into a leaderboard.
+package scores
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+)
+
+// Score is a single completed game.
+type Score struct {
+	Player     string        `json:"player"`
+	Difficulty string        `json:"difficulty"`
+	Attempts   int           `json:"attempts"`
+	Duration   time.Duration `json:"duration"`
+	Timestamp  time.Time     `json:"timestamp"`
+	Won        bool          `json:"won"`
+}
+
+// Store loads and saves scores. Implementations can substitute an
+// in-memory store in tests.
+type Store interface {
+	Load() ([]Score, error)
+	Save(Score) error
+}
+
+// FileStore persists scores as a JSON array at Path.
+type FileStore struct {
+	Path string
+}
+
+// NewFileStore returns a FileStore backed by path.
+func NewFileStore(path string) *FileStore {
+	return &FileStore{Path: path}
+}
+
+// DefaultPath resolves the scores file location: $XDG_DATA_HOME/the-editor/scores.json,
+// falling back to ~/.the-editor/scores.json when XDG_DATA_HOME is unset.
+func DefaultPath() (string, error) {
+	if dir := os.Getenv("XDG_DATA_HOME"); dir != "" {
+		return filepath.Join(dir, "the-editor", "scores.json"), nil
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ".the-editor", "scores.json"), nil
+}
+
+// Load returns the scores recorded so far, or nil if the file doesn't exist yet.
+func (s *FileStore) Load() ([]Score, error) {
+	data, err := os.ReadFile(s.Path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var result []Score
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// Save appends score to the file, creating its parent directory if needed.
+func (s *FileStore) Save(score Score) error {
+	existing, err := s.Load()
+	if err != nil {
+		return err
+	}
+	existing = append(existing, score)
+
+	if err := os.MkdirAll(filepath.Dir(s.Path), 0o755); err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(existing, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(s.Path, data, 0o644)
+}
+
+// Reset deletes the scores file. It is not an error if the file doesn't exist.
+func (s *FileStore) Reset() error {
+	if err := os.Remove(s.Path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+// Top returns up to n winning scores from all, sorted by fewest attempts,
+// with ties broken by shortest duration.
+func Top(all []Score, n int) []Score {
+	won := make([]Score, 0, len(all))
+	for _, s := range all {
+		if s.Won {
+			won = append(won, s)
+		}
+	}
+
+	sort.Slice(won, func(i, j int) bool {
+		if won[i].Attempts != won[j].Attempts {
+			return won[i].Attempts < won[j].Attempts
+		}
+		return won[i].Duration < won[j].Duration
+	})
+
+	if len(won) > n {
+		won = won[:n]
+	}
+	return won
+}
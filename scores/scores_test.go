@@ -0,0 +1,84 @@
+package scores
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestFileStoreSaveLoad(t *testing.T) {
+	store := NewFileStore(filepath.Join(t.TempDir(), "scores.json"))
+
+	got, err := store.Load()
+	if err != nil {
+		t.Fatalf("Load() on missing file: %v", err)
+	}
+	if len(got) != 0 {
+		t.Fatalf("Load() on missing file = %v, want empty", got)
+	}
+
+	want := Score{Player: "ada", Difficulty: "medium", Attempts: 3, Duration: 2 * time.Second, Won: true}
+	if err := store.Save(want); err != nil {
+		t.Fatalf("Save() error: %v", err)
+	}
+
+	got, err = store.Load()
+	if err != nil {
+		t.Fatalf("Load() error: %v", err)
+	}
+	if len(got) != 1 || got[0].Player != want.Player || got[0].Attempts != want.Attempts {
+		t.Fatalf("Load() = %+v, want one score matching %+v", got, want)
+	}
+}
+
+func TestFileStoreReset(t *testing.T) {
+	store := NewFileStore(filepath.Join(t.TempDir(), "scores.json"))
+
+	if err := store.Save(Score{Player: "ada", Attempts: 1, Won: true}); err != nil {
+		t.Fatalf("Save() error: %v", err)
+	}
+	if err := store.Reset(); err != nil {
+		t.Fatalf("Reset() error: %v", err)
+	}
+
+	got, err := store.Load()
+	if err != nil {
+		t.Fatalf("Load() after reset: %v", err)
+	}
+	if len(got) != 0 {
+		t.Fatalf("Load() after reset = %v, want empty", got)
+	}
+
+	if err := store.Reset(); err != nil {
+		t.Fatalf("Reset() on already-clear store: %v", err)
+	}
+}
+
+func TestTop(t *testing.T) {
+	all := []Score{
+		{Player: "a", Attempts: 5, Duration: 10 * time.Second, Won: true},
+		{Player: "b", Attempts: 3, Duration: 20 * time.Second, Won: true},
+		{Player: "c", Attempts: 3, Duration: 5 * time.Second, Won: true},
+		{Player: "d", Attempts: 1, Duration: time.Second, Won: false}, // loss, excluded
+	}
+
+	got := Top(all, 10)
+	if len(got) != 3 {
+		t.Fatalf("Top() returned %d scores, want 3 (losses excluded)", len(got))
+	}
+	if got[0].Player != "c" || got[1].Player != "b" || got[2].Player != "a" {
+		t.Fatalf("Top() order = %v, want [c b a]", got)
+	}
+}
+
+func TestTopLimit(t *testing.T) {
+	all := make([]Score, 5)
+	for i := range all {
+		all[i] = Score{Player: "p", Attempts: i + 1, Won: true}
+	}
+
+	got := Top(all, 2)
+	if len(got) != 2 {
+		t.Fatalf("Top() returned %d scores, want 2", len(got))
+	}
+}